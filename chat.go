@@ -0,0 +1,315 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// sendConfig accumulates the parameters of an outgoing chat.postMessage (or
+// chat.update / chat.postEphemeral / chat.scheduleMessage) call. MsgOption
+// values mutate it before it is form-encoded and posted.
+type sendConfig struct {
+	channel        string
+	text           string
+	attachments    []Attachment
+	blocks         []Block
+	threadTs       string
+	replyBroadcast bool
+	asUser         bool
+	ephemeralUser  string
+	endpoint       string
+	scheduleTime   time.Time
+	unfurlLinks    *bool
+	iconEmoji      string
+	username       string
+}
+
+// MsgOption mutates a sendConfig; apply a set of MsgOptions to build up the
+// parameters of a chat.postMessage-family call
+type MsgOption func(*sendConfig)
+
+// MsgOptionText sets the message text
+func MsgOptionText(text string) MsgOption {
+	return func(c *sendConfig) { c.text = text }
+}
+
+// MsgOptionAttachments sets the message's legacy attachments
+func MsgOptionAttachments(attachments ...Attachment) MsgOption {
+	return func(c *sendConfig) { c.attachments = attachments }
+}
+
+// MsgOptionBlocks sets the message's Block Kit blocks
+func MsgOptionBlocks(blocks ...Block) MsgOption {
+	return func(c *sendConfig) { c.blocks = blocks }
+}
+
+// MsgOptionTS replies in a thread rooted at the given parent message timestamp
+func MsgOptionTS(ts string) MsgOption {
+	return func(c *sendConfig) { c.threadTs = ts }
+}
+
+// MsgOptionBroadcast also sends a threaded reply to the channel
+func MsgOptionBroadcast() MsgOption {
+	return func(c *sendConfig) { c.replyBroadcast = true }
+}
+
+// MsgOptionAsUser posts the message with the authenticated user's identity
+// rather than the app's bot identity
+func MsgOptionAsUser(asUser bool) MsgOption {
+	return func(c *sendConfig) { c.asUser = asUser }
+}
+
+// MsgOptionPostEphemeral scopes the message to be visible only to userID,
+// redirecting the send to chat.postEphemeral regardless of which *Context
+// method it was passed to. PostEphemeral sets this itself from its userID
+// argument, so callers don't need to add it there too.
+func MsgOptionPostEphemeral(userID string) MsgOption {
+	return func(c *sendConfig) {
+		c.ephemeralUser = userID
+		c.endpoint = "chat.postEphemeral"
+	}
+}
+
+// MsgOptionScheduleTime schedules the message to be sent at t.
+// Only meaningful with ScheduleMessage.
+func MsgOptionScheduleTime(t time.Time) MsgOption {
+	return func(c *sendConfig) { c.scheduleTime = t }
+}
+
+// MsgOptionUnfurlLinks enables or disables link unfurling
+func MsgOptionUnfurlLinks(unfurl bool) MsgOption {
+	return func(c *sendConfig) { c.unfurlLinks = &unfurl }
+}
+
+// MsgOptionIconEmoji overrides the bot's icon with an emoji, e.g. ":robot_face:"
+func MsgOptionIconEmoji(emoji string) MsgOption {
+	return func(c *sendConfig) { c.iconEmoji = emoji }
+}
+
+// MsgOptionUsername overrides the bot's display name for this message
+func MsgOptionUsername(username string) MsgOption {
+	return func(c *sendConfig) { c.username = username }
+}
+
+func applyMsgOptions(channelID string, options ...MsgOption) (*sendConfig, error) {
+	cfg := &sendConfig{channel: channelID}
+	for _, opt := range options {
+		opt(cfg)
+	}
+	return cfg, nil
+}
+
+func (cfg *sendConfig) values(token string) (url.Values, error) {
+	values := url.Values{
+		"token":   {token},
+		"channel": {cfg.channel},
+	}
+	if cfg.text != "" {
+		values.Add("text", cfg.text)
+	}
+	if len(cfg.attachments) != 0 {
+		raw, err := json.Marshal(cfg.attachments)
+		if err != nil {
+			return nil, err
+		}
+		values.Add("attachments", string(raw))
+	}
+	if len(cfg.blocks) != 0 {
+		raw, err := json.Marshal(cfg.blocks)
+		if err != nil {
+			return nil, err
+		}
+		values.Add("blocks", string(raw))
+	}
+	if cfg.threadTs != "" {
+		values.Add("thread_ts", cfg.threadTs)
+	}
+	if cfg.replyBroadcast {
+		values.Add("reply_broadcast", strconv.FormatBool(cfg.replyBroadcast))
+	}
+	if cfg.asUser {
+		values.Add("as_user", strconv.FormatBool(cfg.asUser))
+	}
+	if cfg.unfurlLinks != nil {
+		values.Add("unfurl_links", strconv.FormatBool(*cfg.unfurlLinks))
+	}
+	if cfg.ephemeralUser != "" {
+		values.Add("user", cfg.ephemeralUser)
+	}
+	if cfg.iconEmoji != "" {
+		values.Add("icon_emoji", cfg.iconEmoji)
+	}
+	if cfg.username != "" {
+		values.Add("username", cfg.username)
+	}
+	return values, nil
+}
+
+// PostMessage sends a message to a channel with the given options
+func (api *Client) PostMessage(channelID string, options ...MsgOption) (string, string, error) {
+	return api.PostMessageContext(context.Background(), channelID, options...)
+}
+
+// PostMessageContext sends a message to a channel with the given options and
+// a custom context. Passing MsgOptionPostEphemeral redirects the send to
+// chat.postEphemeral, which doesn't echo back a channel, so the first return
+// value is "" in that case.
+func (api *Client) PostMessageContext(ctx context.Context, channelID string, options ...MsgOption) (string, string, error) {
+	cfg, err := applyMsgOptions(channelID, options...)
+	if err != nil {
+		return "", "", err
+	}
+	values, err := cfg.values(api.token)
+	if err != nil {
+		return "", "", err
+	}
+	endpoint := "chat.postMessage"
+	if cfg.endpoint != "" {
+		endpoint = cfg.endpoint
+	}
+	response := struct {
+		SlackResponse
+		Channel   string `json:"channel"`
+		Timestamp string `json:"ts"`
+		MessageTs string `json:"message_ts"`
+	}{}
+	err = post(ctx, api.httpclient, endpoint, values, &response, api.debug)
+	if err != nil {
+		return "", "", err
+	}
+	if !response.Ok {
+		return "", "", errors.New(response.Error)
+	}
+	if response.Timestamp != "" {
+		return response.Channel, response.Timestamp, nil
+	}
+	return response.Channel, response.MessageTs, nil
+}
+
+// UpdateMessage edits an existing message
+func (api *Client) UpdateMessage(channelID, ts string, options ...MsgOption) (string, string, string, error) {
+	return api.UpdateMessageContext(context.Background(), channelID, ts, options...)
+}
+
+// UpdateMessageContext edits an existing message with a custom context
+func (api *Client) UpdateMessageContext(ctx context.Context, channelID, ts string, options ...MsgOption) (string, string, string, error) {
+	cfg, err := applyMsgOptions(channelID, options...)
+	if err != nil {
+		return "", "", "", err
+	}
+	values, err := cfg.values(api.token)
+	if err != nil {
+		return "", "", "", err
+	}
+	values.Add("ts", ts)
+	response := struct {
+		SlackResponse
+		Channel   string `json:"channel"`
+		Timestamp string `json:"ts"`
+		Text      string `json:"text"`
+	}{}
+	err = post(ctx, api.httpclient, "chat.update", values, &response, api.debug)
+	if err != nil {
+		return "", "", "", err
+	}
+	if !response.Ok {
+		return "", "", "", errors.New(response.Error)
+	}
+	return response.Channel, response.Timestamp, response.Text, nil
+}
+
+// DeleteMessage deletes a message
+func (api *Client) DeleteMessage(channelID, ts string) (string, string, error) {
+	return api.DeleteMessageContext(context.Background(), channelID, ts)
+}
+
+// DeleteMessageContext deletes a message with a custom context
+func (api *Client) DeleteMessageContext(ctx context.Context, channelID, ts string) (string, string, error) {
+	values := url.Values{
+		"token":   {api.token},
+		"channel": {channelID},
+		"ts":      {ts},
+	}
+	response := struct {
+		SlackResponse
+		Channel   string `json:"channel"`
+		Timestamp string `json:"ts"`
+	}{}
+	err := post(ctx, api.httpclient, "chat.delete", values, &response, api.debug)
+	if err != nil {
+		return "", "", err
+	}
+	if !response.Ok {
+		return "", "", errors.New(response.Error)
+	}
+	return response.Channel, response.Timestamp, nil
+}
+
+// PostEphemeral sends a message visible only to userID in channelID
+func (api *Client) PostEphemeral(channelID, userID string, options ...MsgOption) (string, error) {
+	return api.PostEphemeralContext(context.Background(), channelID, userID, options...)
+}
+
+// PostEphemeralContext sends a message visible only to userID in channelID with a custom context
+func (api *Client) PostEphemeralContext(ctx context.Context, channelID, userID string, options ...MsgOption) (string, error) {
+	cfg, err := applyMsgOptions(channelID, options...)
+	if err != nil {
+		return "", err
+	}
+	cfg.ephemeralUser = userID
+	values, err := cfg.values(api.token)
+	if err != nil {
+		return "", err
+	}
+	response := struct {
+		SlackResponse
+		Timestamp string `json:"message_ts"`
+	}{}
+	err = post(ctx, api.httpclient, "chat.postEphemeral", values, &response, api.debug)
+	if err != nil {
+		return "", err
+	}
+	if !response.Ok {
+		return "", errors.New(response.Error)
+	}
+	return response.Timestamp, nil
+}
+
+// ScheduleMessage schedules a message to be sent at a future time set via
+// MsgOptionScheduleTime
+func (api *Client) ScheduleMessage(channelID string, options ...MsgOption) (string, string, error) {
+	return api.ScheduleMessageContext(context.Background(), channelID, options...)
+}
+
+// ScheduleMessageContext schedules a message to be sent at a future time with a custom context
+func (api *Client) ScheduleMessageContext(ctx context.Context, channelID string, options ...MsgOption) (string, string, error) {
+	cfg, err := applyMsgOptions(channelID, options...)
+	if err != nil {
+		return "", "", err
+	}
+	if cfg.scheduleTime.IsZero() {
+		return "", "", errors.New("slack: MsgOptionScheduleTime is required for ScheduleMessage")
+	}
+	values, err := cfg.values(api.token)
+	if err != nil {
+		return "", "", err
+	}
+	values.Add("post_at", strconv.FormatInt(cfg.scheduleTime.Unix(), 10))
+	response := struct {
+		SlackResponse
+		Channel string `json:"channel"`
+		PostAt  string `json:"post_at"`
+	}{}
+	err = post(ctx, api.httpclient, "chat.scheduleMessage", values, &response, api.debug)
+	if err != nil {
+		return "", "", err
+	}
+	if !response.Ok {
+		return "", "", errors.New(response.Error)
+	}
+	return response.Channel, response.PostAt, nil
+}
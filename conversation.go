@@ -69,7 +69,7 @@ func (api *Client) GetUsersInConversationContext(ctx context.Context, params *Ge
 		values.Add("cursor", params.Cursor)
 	}
 	if params.Limit != 0 {
-		values.Add("limit", string(params.Limit))
+		values.Add("limit", strconv.Itoa(params.Limit))
 	}
 	response := struct {
 		Members          []string         `json:"members"`
@@ -329,3 +329,267 @@ func (api *Client) GetConversationInfoContext(ctx context.Context, channelID str
 	}
 	return &response.Channel, nil
 }
+
+// GetConversationHistoryParameters contains arguments for GetConversationHistory
+type GetConversationHistoryParameters struct {
+	ChannelID string
+	Cursor    string
+	Inclusive bool
+	Latest    string
+	Limit     int
+	Oldest    string
+}
+
+// GetConversationHistory retrieves a channel's messages
+func (api *Client) GetConversationHistory(params *GetConversationHistoryParameters) (*GetConversationHistoryResponse, error) {
+	return api.GetConversationHistoryContext(context.Background(), params)
+}
+
+// GetConversationHistoryContext retrieves a channel's messages with a custom context
+func (api *Client) GetConversationHistoryContext(ctx context.Context, params *GetConversationHistoryParameters) (*GetConversationHistoryResponse, error) {
+	values := url.Values{
+		"token":     {api.token},
+		"channel":   {params.ChannelID},
+		"inclusive": {strconv.FormatBool(params.Inclusive)},
+	}
+	if params.Cursor != "" {
+		values.Add("cursor", params.Cursor)
+	}
+	if params.Limit != 0 {
+		values.Add("limit", strconv.Itoa(params.Limit))
+	}
+	if params.Latest != "" {
+		values.Add("latest", params.Latest)
+	}
+	if params.Oldest != "" {
+		values.Add("oldest", params.Oldest)
+	}
+	response := GetConversationHistoryResponse{}
+	err := post(ctx, api.httpclient, "conversations.history", values, &response, api.debug)
+	if err != nil {
+		return nil, err
+	}
+	if !response.Ok {
+		return nil, errors.New(response.Error)
+	}
+	return &response, nil
+}
+
+// GetConversationHistoryResponse is the response returned by conversations.history
+type GetConversationHistoryResponse struct {
+	SlackResponse
+	HasMore          bool             `json:"has_more"`
+	PinCount         int              `json:"pin_count"`
+	Latest           string           `json:"latest"`
+	Messages         []Message        `json:"messages"`
+	ResponseMetaData responseMetaData `json:"response_metadata"`
+}
+
+// GetConversationRepliesParameters contains arguments for GetConversationReplies
+type GetConversationRepliesParameters struct {
+	ChannelID string
+	Timestamp string
+	Cursor    string
+	Inclusive bool
+	Latest    string
+	Limit     int
+	Oldest    string
+}
+
+// GetConversationReplies retrieves a thread's messages
+func (api *Client) GetConversationReplies(params *GetConversationRepliesParameters) ([]Message, bool, string, error) {
+	return api.GetConversationRepliesContext(context.Background(), params)
+}
+
+// GetConversationRepliesContext retrieves a thread's messages with a custom context
+func (api *Client) GetConversationRepliesContext(ctx context.Context, params *GetConversationRepliesParameters) ([]Message, bool, string, error) {
+	values := url.Values{
+		"token":     {api.token},
+		"channel":   {params.ChannelID},
+		"ts":        {params.Timestamp},
+		"inclusive": {strconv.FormatBool(params.Inclusive)},
+	}
+	if params.Cursor != "" {
+		values.Add("cursor", params.Cursor)
+	}
+	if params.Limit != 0 {
+		values.Add("limit", strconv.Itoa(params.Limit))
+	}
+	if params.Latest != "" {
+		values.Add("latest", params.Latest)
+	}
+	if params.Oldest != "" {
+		values.Add("oldest", params.Oldest)
+	}
+	response := struct {
+		SlackResponse
+		HasMore          bool             `json:"has_more"`
+		Messages         []Message        `json:"messages"`
+		ResponseMetaData responseMetaData `json:"response_metadata"`
+	}{}
+	err := post(ctx, api.httpclient, "conversations.replies", values, &response, api.debug)
+	if err != nil {
+		return nil, false, "", err
+	}
+	if !response.Ok {
+		return nil, false, "", errors.New(response.Error)
+	}
+	return response.Messages, response.HasMore, response.ResponseMetaData.NextCursor, nil
+}
+
+// JoinConversation joins an existing conversation
+func (api *Client) JoinConversation(channelID string) (*Channel, string, error) {
+	return api.JoinConversationContext(context.Background(), channelID)
+}
+
+// JoinConversationContext joins an existing conversation with a custom context
+func (api *Client) JoinConversationContext(ctx context.Context, channelID string) (*Channel, string, error) {
+	values := url.Values{
+		"token":   {api.token},
+		"channel": {channelID},
+	}
+	response := struct {
+		SlackResponse
+		Channel *Channel `json:"channel"`
+		Warning string   `json:"warning"`
+	}{}
+	err := post(ctx, api.httpclient, "conversations.join", values, &response, api.debug)
+	if err != nil {
+		return nil, "", err
+	}
+	if !response.Ok {
+		return nil, "", errors.New(response.Error)
+	}
+	return response.Channel, response.Warning, nil
+}
+
+// LeaveConversation leaves a conversation
+func (api *Client) LeaveConversation(channelID string) (bool, error) {
+	return api.LeaveConversationContext(context.Background(), channelID)
+}
+
+// LeaveConversationContext leaves a conversation with a custom context
+func (api *Client) LeaveConversationContext(ctx context.Context, channelID string) (bool, error) {
+	values := url.Values{
+		"token":   {api.token},
+		"channel": {channelID},
+	}
+	response := struct {
+		SlackResponse
+		NotInChannel bool `json:"not_in_channel"`
+	}{}
+	err := post(ctx, api.httpclient, "conversations.leave", values, &response, api.debug)
+	if err != nil {
+		return false, err
+	}
+	if !response.Ok {
+		return false, errors.New(response.Error)
+	}
+	return response.NotInChannel, nil
+}
+
+// GetConversationsParameters contains arguments for GetConversations
+type GetConversationsParameters struct {
+	Cursor          string
+	ExcludeArchived bool
+	Limit           int
+	Types           []string
+}
+
+// GetConversations returns a list of conversations in the workspace
+func (api *Client) GetConversations(params *GetConversationsParameters) ([]Channel, string, error) {
+	return api.GetConversationsContext(context.Background(), params)
+}
+
+// GetConversationsContext returns a list of conversations in the workspace with a custom context
+func (api *Client) GetConversationsContext(ctx context.Context, params *GetConversationsParameters) ([]Channel, string, error) {
+	values := url.Values{
+		"token":            {api.token},
+		"exclude_archived": {strconv.FormatBool(params.ExcludeArchived)},
+	}
+	if params.Cursor != "" {
+		values.Add("cursor", params.Cursor)
+	}
+	if params.Limit != 0 {
+		values.Add("limit", strconv.Itoa(params.Limit))
+	}
+	if len(params.Types) != 0 {
+		values.Add("types", strings.Join(params.Types, ","))
+	}
+	response := struct {
+		SlackResponse
+		Channels         []Channel        `json:"channels"`
+		ResponseMetaData responseMetaData `json:"response_metadata"`
+	}{}
+	err := post(ctx, api.httpclient, "conversations.list", values, &response, api.debug)
+	if err != nil {
+		return nil, "", err
+	}
+	if !response.Ok {
+		return nil, "", errors.New(response.Error)
+	}
+	return response.Channels, response.ResponseMetaData.NextCursor, nil
+}
+
+// OpenConversationParameters contains arguments for OpenConversation
+type OpenConversationParameters struct {
+	ChannelID string
+	ReturnIM  bool
+	Users     []string
+}
+
+// OpenConversation opens or resumes a direct or multi-person direct message
+func (api *Client) OpenConversation(params *OpenConversationParameters) (*Channel, bool, bool, error) {
+	return api.OpenConversationContext(context.Background(), params)
+}
+
+// OpenConversationContext opens or resumes a direct or multi-person direct message with a custom context
+func (api *Client) OpenConversationContext(ctx context.Context, params *OpenConversationParameters) (*Channel, bool, bool, error) {
+	values := url.Values{
+		"token":     {api.token},
+		"return_im": {strconv.FormatBool(params.ReturnIM)},
+	}
+	if params.ChannelID != "" {
+		values.Add("channel", params.ChannelID)
+	}
+	if len(params.Users) != 0 {
+		values.Add("users", strings.Join(params.Users, ","))
+	}
+	response := struct {
+		SlackResponse
+		NoOp        bool     `json:"no_op"`
+		AlreadyOpen bool     `json:"already_open"`
+		Channel     *Channel `json:"channel"`
+	}{}
+	err := post(ctx, api.httpclient, "conversations.open", values, &response, api.debug)
+	if err != nil {
+		return nil, false, false, err
+	}
+	if !response.Ok {
+		return nil, false, false, errors.New(response.Error)
+	}
+	return response.Channel, response.NoOp, response.AlreadyOpen, nil
+}
+
+// MarkConversation sets the read cursor for a conversation
+func (api *Client) MarkConversation(channelID, ts string) error {
+	return api.MarkConversationContext(context.Background(), channelID, ts)
+}
+
+// MarkConversationContext sets the read cursor for a conversation with a custom context
+func (api *Client) MarkConversationContext(ctx context.Context, channelID, ts string) error {
+	values := url.Values{
+		"token":   {api.token},
+		"channel": {channelID},
+		"ts":      {ts},
+	}
+	response := SlackResponse{}
+	err := post(ctx, api.httpclient, "conversations.mark", values, &response, api.debug)
+	if err != nil {
+		return err
+	}
+	if !response.Ok {
+		return errors.New(response.Error)
+	}
+	return nil
+}
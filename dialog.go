@@ -0,0 +1,176 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+)
+
+// DialogElement is implemented by the concrete dialog element types
+// (DialogTextElement, DialogTextareaElement, DialogSelectElement) so they
+// can be collected in a Dialog's Elements slice.
+type DialogElement interface {
+	DialogElementType() string
+}
+
+// DialogTextElement is a single-line text input element of a Dialog
+type DialogTextElement struct {
+	Type        string `json:"type"`
+	Label       string `json:"label"`
+	Name        string `json:"name"`
+	Placeholder string `json:"placeholder,omitempty"`
+	Optional    bool   `json:"optional,omitempty"`
+	Hint        string `json:"hint,omitempty"`
+	Value       string `json:"value,omitempty"`
+	MinLength   int    `json:"min_length,omitempty"`
+	MaxLength   int    `json:"max_length,omitempty"`
+}
+
+// DialogElementType returns the discriminator Slack expects for this element
+func (e DialogTextElement) DialogElementType() string { return "text" }
+
+// NewDialogTextElement returns a single-line text element for use in a Dialog
+func NewDialogTextElement(label, name, placeholder string) DialogTextElement {
+	return DialogTextElement{Type: "text", Label: label, Name: name, Placeholder: placeholder}
+}
+
+// DialogTextareaElement is a multi-line text input element of a Dialog
+type DialogTextareaElement struct {
+	Type        string `json:"type"`
+	Label       string `json:"label"`
+	Name        string `json:"name"`
+	Placeholder string `json:"placeholder,omitempty"`
+	Optional    bool   `json:"optional,omitempty"`
+	Hint        string `json:"hint,omitempty"`
+	Value       string `json:"value,omitempty"`
+	MinLength   int    `json:"min_length,omitempty"`
+	MaxLength   int    `json:"max_length,omitempty"`
+}
+
+// DialogElementType returns the discriminator Slack expects for this element
+func (e DialogTextareaElement) DialogElementType() string { return "textarea" }
+
+// NewDialogTextareaElement returns a multi-line text element for use in a Dialog
+func NewDialogTextareaElement(label, name, placeholder string) DialogTextareaElement {
+	return DialogTextareaElement{Type: "textarea", Label: label, Name: name, Placeholder: placeholder}
+}
+
+// DialogSelectOption is a single choice offered by a DialogSelectElement
+type DialogSelectOption struct {
+	Label string `json:"label"`
+	Value string `json:"value"`
+}
+
+// DialogSelectElement is a select-menu element of a Dialog. DataSource
+// selects where the options come from: "users", "channels", "conversations",
+// or "external" (in which case Options is left empty and Slack calls back
+// to the app's options-load URL).
+type DialogSelectElement struct {
+	Type        string               `json:"type"`
+	Label       string               `json:"label"`
+	Name        string               `json:"name"`
+	Placeholder string               `json:"placeholder,omitempty"`
+	Optional    bool                 `json:"optional,omitempty"`
+	DataSource  string               `json:"data_source,omitempty"`
+	Value       string               `json:"value,omitempty"`
+	Options     []DialogSelectOption `json:"options,omitempty"`
+}
+
+// DialogElementType returns the discriminator Slack expects for this element
+func (e DialogSelectElement) DialogElementType() string { return "select" }
+
+// NewDialogSelectElement returns a select element populated with static options
+func NewDialogSelectElement(label, name string, options []DialogSelectOption) DialogSelectElement {
+	return DialogSelectElement{Type: "select", Label: label, Name: name, Options: options}
+}
+
+// NewDialogSelectElementWithDataSource returns a select element whose options
+// are dynamically supplied by Slack, e.g. "users", "channels", "conversations" or "external"
+func NewDialogSelectElementWithDataSource(label, name, dataSource string) DialogSelectElement {
+	return DialogSelectElement{Type: "select", Label: label, Name: name, DataSource: dataSource}
+}
+
+// Dialog describes a Block Kit dialog to be opened in response to a
+// trigger_id, e.g. from a slash command or a message action
+type Dialog struct {
+	CallbackID     string          `json:"callback_id"`
+	Title          string          `json:"title"`
+	SubmitLabel    string          `json:"submit_label,omitempty"`
+	NotifyOnCancel bool            `json:"notify_on_cancel,omitempty"`
+	State          string          `json:"state,omitempty"`
+	Elements       []DialogElement `json:"elements"`
+}
+
+// NewDialog returns a Dialog with the required title and callback_id set
+func NewDialog(title, callbackID string) Dialog {
+	return Dialog{Title: title, CallbackID: callbackID}
+}
+
+// AddElement appends an element to the dialog and returns the dialog for chaining
+func (d Dialog) AddElement(e DialogElement) Dialog {
+	d.Elements = append(d.Elements, e)
+	return d
+}
+
+// MarshalJSON stamps each element's "type" field from DialogElementType()
+// before encoding, so a caller who builds an element as a bare struct literal
+// (skipping the NewDialog*Element constructors) still produces a valid
+// discriminator on the wire.
+func (d Dialog) MarshalJSON() ([]byte, error) {
+	type dialogAlias Dialog
+	aux := dialogAlias(d)
+	aux.Elements = make([]DialogElement, len(d.Elements))
+	for i, e := range d.Elements {
+		aux.Elements[i] = stampDialogElementType(e)
+	}
+	return json.Marshal(aux)
+}
+
+// stampDialogElementType returns a copy of e with its Type field set from
+// DialogElementType(), overriding whatever the caller left it as
+func stampDialogElementType(e DialogElement) DialogElement {
+	switch elem := e.(type) {
+	case DialogTextElement:
+		elem.Type = elem.DialogElementType()
+		return elem
+	case DialogTextareaElement:
+		elem.Type = elem.DialogElementType()
+		return elem
+	case DialogSelectElement:
+		elem.Type = elem.DialogElementType()
+		return elem
+	default:
+		return e
+	}
+}
+
+// OpenDialog opens a dialog in response to a trigger_id
+func (api *Client) OpenDialog(triggerID string, dialog Dialog) error {
+	return api.OpenDialogContext(context.Background(), triggerID, dialog)
+}
+
+// OpenDialogContext opens a dialog in response to a trigger_id with a custom context
+func (api *Client) OpenDialogContext(ctx context.Context, triggerID string, dialog Dialog) error {
+	if triggerID == "" {
+		return errors.New("slack: triggerID must be set")
+	}
+	raw, err := json.Marshal(dialog)
+	if err != nil {
+		return err
+	}
+	values := url.Values{
+		"token":      {api.token},
+		"trigger_id": {triggerID},
+		"dialog":     {string(raw)},
+	}
+	response := SlackResponse{}
+	err = post(ctx, api.httpclient, "dialog.open", values, &response, api.debug)
+	if err != nil {
+		return err
+	}
+	if !response.Ok {
+		return errors.New(response.Error)
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package slack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+// InteractionType identifies what kind of interactive payload Slack posted
+// to a Request URL
+type InteractionType string
+
+const (
+	// InteractionTypeDialogSubmission is posted when a user submits a dialog
+	InteractionTypeDialogSubmission InteractionType = "dialog_submission"
+	// InteractionTypeDialogCancellation is posted when a user cancels a dialog
+	InteractionTypeDialogCancellation InteractionType = "dialog_cancellation"
+	// InteractionTypeInteractionMessage is posted when a user clicks a button or select menu attached to a message
+	InteractionTypeInteractionMessage InteractionType = "interactive_message"
+	// InteractionTypeBlockActions is posted when a user interacts with a Block Kit element
+	InteractionTypeBlockActions InteractionType = "block_actions"
+)
+
+// InteractionCallback is the payload Slack posts (URL-encoded in a single
+// "payload" form field) to a Request URL whenever a user interacts with a
+// dialog, interactive message, or Block Kit element
+type InteractionCallback struct {
+	Type        InteractionType            `json:"type"`
+	Token       string                     `json:"token"`
+	CallbackID  string                     `json:"callback_id"`
+	User        User                       `json:"user"`
+	Channel     Channel                    `json:"channel"`
+	Team        Team                       `json:"team"`
+	ActionTs    string                     `json:"action_ts"`
+	MessageTs   string                     `json:"message_ts"`
+	ResponseURL string                     `json:"response_url"`
+	TriggerID   string                     `json:"trigger_id"`
+	Actions     []AttachmentActionCallback `json:"actions"`
+	Submission  map[string]string          `json:"submission"`
+	State       string                     `json:"state"`
+}
+
+// AttachmentActionCallback describes a single action a user took on an
+// interactive attachment (a button click or select-menu choice)
+type AttachmentActionCallback struct {
+	Name            string               `json:"name"`
+	Text            string               `json:"text"`
+	Type            string               `json:"type"`
+	Value           string               `json:"value"`
+	SelectedOptions []DialogSelectOption `json:"selected_options,omitempty"`
+}
+
+// ParseInteractionCallback decodes the URL-encoded "payload=" form value
+// Slack POSTs to a Request URL into an InteractionCallback
+func ParseInteractionCallback(payload string) (InteractionCallback, error) {
+	var callback InteractionCallback
+	if payload == "" {
+		return callback, errors.New("slack: empty interaction payload")
+	}
+	if err := json.Unmarshal([]byte(payload), &callback); err != nil {
+		return callback, err
+	}
+	return callback, nil
+}
+
+// RespondToInteraction posts msg back to the ephemeral response_url that
+// accompanied an InteractionCallback
+func (api *Client) RespondToInteraction(ctx context.Context, responseURL string, msg Message) error {
+	raw, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, responseURL, bytes.NewReader(raw))
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := api.httpclient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.New("slack: response_url returned non-200 status")
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package slack
+
+import "context"
+
+// fetchPageFunc retrieves a single page of a cursor-paginated endpoint,
+// returning the page of items and the cursor for the next page ("" once
+// exhausted).
+type fetchPageFunc[T any] func(ctx context.Context, cursor string) ([]T, string, error)
+
+// Paginator walks any cursor-paginated (next_cursor) endpoint one page at a
+// time, hiding the next_cursor bookkeeping from the caller.
+//
+// Usage:
+//
+//	iter := api.GetConversationsIterator(params)
+//	for iter.Next(ctx) {
+//	    channel := iter.Item()
+//	}
+//	if err := iter.Err(); err != nil {
+//	    // handle err
+//	}
+type Paginator[T any] struct {
+	fetch  fetchPageFunc[T]
+	cursor string
+
+	page  []T
+	index int
+
+	done bool
+	err  error
+}
+
+// newPaginator builds an iterator around a page-fetching function
+func newPaginator[T any](fetch fetchPageFunc[T]) *Paginator[T] {
+	return &Paginator[T]{fetch: fetch, index: -1}
+}
+
+// Next advances the iterator, fetching the next page when the current one is
+// exhausted. It returns false once the iterator is done or an error occurred.
+func (it *Paginator[T]) Next(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+	it.index++
+	if it.index < len(it.page) {
+		return true
+	}
+	if it.done {
+		return false
+	}
+	page, cursor, err := it.fetch(ctx, it.cursor)
+	if err != nil {
+		it.err = err
+		return false
+	}
+	it.page = page
+	it.cursor = cursor
+	it.index = 0
+	if cursor == "" {
+		it.done = true
+	}
+	return it.index < len(it.page)
+}
+
+// Item returns the item the iterator is currently positioned at
+func (it *Paginator[T]) Item() T {
+	return it.page[it.index]
+}
+
+// Err returns the first error encountered while paginating, if any
+func (it *Paginator[T]) Err() error {
+	return it.err
+}
+
+// ConversationsIterator pages through conversations.list
+type ConversationsIterator = Paginator[Channel]
+
+// GetConversationsIterator returns a ConversationsIterator over conversations.list
+func (api *Client) GetConversationsIterator(params *GetConversationsParameters) *ConversationsIterator {
+	return newPaginator(func(ctx context.Context, cursor string) ([]Channel, string, error) {
+		p := *params
+		p.Cursor = cursor
+		return api.GetConversationsContext(ctx, &p)
+	})
+}
+
+// GetConversationHistoryIterator returns a Paginator over conversations.history
+func (api *Client) GetConversationHistoryIterator(params *GetConversationHistoryParameters) *Paginator[Message] {
+	return newPaginator(func(ctx context.Context, cursor string) ([]Message, string, error) {
+		p := *params
+		p.Cursor = cursor
+		resp, err := api.GetConversationHistoryContext(ctx, &p)
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Messages, resp.ResponseMetaData.NextCursor, nil
+	})
+}
+
+// GetConversationRepliesIterator returns a Paginator over conversations.replies
+func (api *Client) GetConversationRepliesIterator(params *GetConversationRepliesParameters) *Paginator[Message] {
+	return newPaginator(func(ctx context.Context, cursor string) ([]Message, string, error) {
+		p := *params
+		p.Cursor = cursor
+		messages, _, nextCursor, err := api.GetConversationRepliesContext(ctx, &p)
+		if err != nil {
+			return nil, "", err
+		}
+		return messages, nextCursor, nil
+	})
+}
+
+// GetUsersInConversationIterator returns a Paginator over conversations.members
+func (api *Client) GetUsersInConversationIterator(params *GetUsersInConversationParameters) *Paginator[string] {
+	return newPaginator(func(ctx context.Context, cursor string) ([]string, string, error) {
+		p := *params
+		p.Cursor = cursor
+		return api.GetUsersInConversationContext(ctx, &p)
+	})
+}
@@ -0,0 +1,251 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	rtmEventsChannelSize = 50
+
+	rtmPingInterval = 30 * time.Second
+	rtmPingTimeout  = 10 * time.Second
+	rtmMinBackoff   = 2 * time.Second
+	rtmMaxBackoff   = 5 * time.Minute
+)
+
+// RTMEvent is a single event decoded off the RTM websocket. Type is the
+// "type" discriminator Slack sent, and Data holds the typed event value
+// (e.g. *MessageEvent, *HelloEvent) looked up via rtmEventTypes.
+type RTMEvent struct {
+	Type string
+	Data interface{}
+}
+
+// OutgoingMessage is a message sent by the client over the RTM websocket.
+// Every outgoing message needs a unique, monotonically increasing ID so
+// Slack can correlate the reply frame it echoes back.
+type OutgoingMessage struct {
+	ID      int64  `json:"id"`
+	Type    string `json:"type"`
+	Channel string `json:"channel,omitempty"`
+	Text    string `json:"text,omitempty"`
+}
+
+// RTM represents a managed connection to Slack's real-time messaging
+// websocket. Create one with Client.NewRTM and drive it with ManageConnection.
+type RTM struct {
+	client *Client
+
+	IncomingEvents chan RTMEvent
+	outgoingMsgs   chan OutgoingMessage
+
+	idGen       int64
+	conn        *websocket.Conn
+	cancel      context.CancelFunc
+	lastPong    int64 // unix nano, set at connect and on every PongEvent
+	intentional int32 // 1 once Disconnect has been called
+
+	disconnected chan struct{}
+}
+
+// NewRTM returns an RTM ready to be managed with ManageConnection
+func (api *Client) NewRTM() *RTM {
+	return &RTM{
+		client:         api,
+		IncomingEvents: make(chan RTMEvent, rtmEventsChannelSize),
+		outgoingMsgs:   make(chan OutgoingMessage, rtmEventsChannelSize),
+		disconnected:   make(chan struct{}),
+	}
+}
+
+// rtmConnectResponse is the response to rtm.connect
+type rtmConnectResponse struct {
+	SlackResponse
+	URL  string `json:"url"`
+	Self struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"self"`
+	Team struct {
+		ID     string `json:"id"`
+		Name   string `json:"name"`
+		Domain string `json:"domain"`
+	} `json:"team"`
+}
+
+// connect calls rtm.connect and dials the returned wss:// URL
+func (rtm *RTM) connect(ctx context.Context) error {
+	values := url.Values{
+		"token": {rtm.client.token},
+	}
+	response := rtmConnectResponse{}
+	if err := post(ctx, rtm.client.httpclient, "rtm.connect", values, &response, rtm.client.debug); err != nil {
+		return err
+	}
+	if !response.Ok {
+		return errors.New(response.Error)
+	}
+	conn, _, err := websocket.DefaultDialer.DialContext(ctx, response.URL, nil)
+	if err != nil {
+		return err
+	}
+	rtm.conn = conn
+	atomic.StoreInt64(&rtm.lastPong, time.Now().UnixNano())
+	return nil
+}
+
+// ManageConnection connects to the RTM websocket and keeps it alive for the
+// lifetime of the RTM, automatically reconnecting with backoff on
+// disconnect. It should be run in its own goroutine; events and errors are
+// delivered on IncomingEvents (HelloEvent, DisconnectedEvent, etc).
+func (rtm *RTM) ManageConnection() {
+	ctx, cancel := context.WithCancel(context.Background())
+	rtm.cancel = cancel
+
+	backoff := rtmMinBackoff
+	for {
+		if err := rtm.connect(ctx); err != nil {
+			rtm.IncomingEvents <- RTMEvent{Type: "disconnected", Data: &DisconnectedEvent{Intentional: rtm.wasIntentional()}}
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(backoff):
+			}
+			backoff *= 2
+			if backoff > rtmMaxBackoff {
+				backoff = rtmMaxBackoff
+			}
+			continue
+		}
+		backoff = rtmMinBackoff
+		rtm.IncomingEvents <- RTMEvent{Type: "hello", Data: &HelloEvent{}}
+
+		done := make(chan struct{})
+		go rtm.sendLoop(ctx, done)
+		rtm.receiveLoop(ctx)
+		close(done)
+
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+	}
+}
+
+// Disconnect tears down the managed connection. The resulting
+// DisconnectedEvent on IncomingEvents is reported with Intentional set to
+// true, and any SendMessage call made after this returns an error instead of
+// blocking forever.
+func (rtm *RTM) Disconnect() error {
+	if atomic.CompareAndSwapInt32(&rtm.intentional, 0, 1) {
+		close(rtm.disconnected)
+	}
+	if rtm.cancel != nil {
+		rtm.cancel()
+	}
+	if rtm.conn != nil {
+		return rtm.conn.Close()
+	}
+	return nil
+}
+
+// wasIntentional reports whether Disconnect has been called on this RTM
+func (rtm *RTM) wasIntentional() bool {
+	return atomic.LoadInt32(&rtm.intentional) == 1
+}
+
+// SendMessage enqueues an outgoing message to be written to the websocket,
+// stamping it with the next monotonic message ID. It returns an error
+// instead of blocking if Disconnect has already been called, since nothing
+// drains outgoingMsgs once the managed connection has been torn down for good.
+func (rtm *RTM) SendMessage(msg *OutgoingMessage) error {
+	msg.ID = atomic.AddInt64(&rtm.idGen, 1)
+	select {
+	case rtm.outgoingMsgs <- *msg:
+		return nil
+	case <-rtm.disconnected:
+		return errors.New("slack: RTM is disconnected")
+	}
+}
+
+// NewOutgoingMessage builds a "message" type OutgoingMessage for the given channel
+func (rtm *RTM) NewOutgoingMessage(text, channel string) *OutgoingMessage {
+	return &OutgoingMessage{Type: "message", Channel: channel, Text: text}
+}
+
+func (rtm *RTM) sendLoop(ctx context.Context, done chan struct{}) {
+	ticker := time.NewTicker(rtmPingInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-done:
+			return
+		case msg := <-rtm.outgoingMsgs:
+			if err := rtm.conn.WriteJSON(msg); err != nil {
+				return
+			}
+		case <-ticker.C:
+			lastPong := time.Unix(0, atomic.LoadInt64(&rtm.lastPong))
+			if time.Since(lastPong) > rtmPingInterval+rtmPingTimeout {
+				// no pong since the last ping: the connection is half-dead, so
+				// force it closed to unblock receiveLoop and trigger a reconnect
+				rtm.conn.Close()
+				return
+			}
+			ping := OutgoingMessage{ID: atomic.AddInt64(&rtm.idGen, 1), Type: "ping"}
+			if err := rtm.conn.WriteJSON(ping); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (rtm *RTM) receiveLoop(ctx context.Context) {
+	for {
+		_, raw, err := rtm.conn.ReadMessage()
+		if err != nil {
+			rtm.IncomingEvents <- RTMEvent{Type: "disconnected", Data: &DisconnectedEvent{Intentional: rtm.wasIntentional()}}
+			return
+		}
+		event, err := unmarshalRTMEvent(raw)
+		if err != nil {
+			continue
+		}
+		if event.Type == "pong" {
+			atomic.StoreInt64(&rtm.lastPong, time.Now().UnixNano())
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case rtm.IncomingEvents <- event:
+		}
+	}
+}
+
+func unmarshalRTMEvent(raw []byte) (RTMEvent, error) {
+	header := struct {
+		Type string `json:"type"`
+	}{}
+	if err := json.Unmarshal(raw, &header); err != nil {
+		return RTMEvent{}, err
+	}
+	newEvent, ok := rtmEventTypes[header.Type]
+	if !ok {
+		return RTMEvent{Type: header.Type, Data: json.RawMessage(raw)}, nil
+	}
+	data := newEvent()
+	if err := json.Unmarshal(raw, data); err != nil {
+		return RTMEvent{}, err
+	}
+	return RTMEvent{Type: header.Type, Data: data}, nil
+}
@@ -0,0 +1,64 @@
+package slack
+
+// rtmEventTypes maps the "type" discriminator of an RTM frame to a
+// constructor for its typed event value, used by unmarshalRTMEvent to decode
+// incoming frames without a giant type switch.
+var rtmEventTypes = map[string]func() interface{}{
+	"hello":          func() interface{} { return &HelloEvent{} },
+	"message":        func() interface{} { return &MessageEvent{} },
+	"reaction_added": func() interface{} { return &ReactionAddedEvent{} },
+	"user_typing":    func() interface{} { return &UserTypingEvent{} },
+	"channel_joined": func() interface{} { return &ChannelJoinedEvent{} },
+	"pong":           func() interface{} { return &PongEvent{} },
+}
+
+// HelloEvent is sent once as the first frame after a successful connection
+type HelloEvent struct{}
+
+// MessageEvent is sent for every new message posted to a channel the client can see
+type MessageEvent struct {
+	Type      string `json:"type"`
+	Channel   string `json:"channel"`
+	User      string `json:"user"`
+	Text      string `json:"text"`
+	Timestamp string `json:"ts"`
+	ThreadTs  string `json:"thread_ts,omitempty"`
+}
+
+// ReactionAddedEvent is sent when a reaction is added to a message
+type ReactionAddedEvent struct {
+	Type     string `json:"type"`
+	User     string `json:"user"`
+	Reaction string `json:"reaction"`
+	ItemUser string `json:"item_user"`
+	Item     struct {
+		Type    string `json:"type"`
+		Channel string `json:"channel"`
+		Ts      string `json:"ts"`
+	} `json:"item"`
+}
+
+// UserTypingEvent is sent when a user starts typing in a channel
+type UserTypingEvent struct {
+	Type    string `json:"type"`
+	Channel string `json:"channel"`
+	User    string `json:"user"`
+}
+
+// ChannelJoinedEvent is sent when the client joins a channel
+type ChannelJoinedEvent struct {
+	Type    string  `json:"type"`
+	Channel Channel `json:"channel"`
+}
+
+// PongEvent is Slack's reply to a "ping" OutgoingMessage
+type PongEvent struct {
+	Type    string `json:"type"`
+	ReplyTo int64  `json:"reply_to"`
+}
+
+// DisconnectedEvent is emitted locally (never sent by Slack) whenever the
+// managed connection drops, whether intentionally via Disconnect or not
+type DisconnectedEvent struct {
+	Intentional bool
+}
@@ -0,0 +1,171 @@
+// Package slackexport parses a Slack workspace export ZIP (channels.json,
+// users.json, and one <channel>/YYYY-MM-DD.json file per day) into the
+// typed values used elsewhere in this module.
+package slackexport
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/suzuki-shunsuke/slack"
+)
+
+// Export is a parsed Slack workspace export. Channels and Users are decoded
+// eagerly since exports keep them small; per-channel messages are streamed
+// lazily via Messages to avoid buffering a potentially huge export in memory.
+type Export struct {
+	Channels []slack.Channel
+	Users    []slack.User
+
+	zipReader *zip.Reader
+}
+
+// ParseExport opens a Slack export ZIP and decodes its channels.json and
+// users.json. Per-day message files are left unread until Messages is called.
+func ParseExport(r io.ReaderAt, size int64) (*Export, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, err
+	}
+	e := &Export{zipReader: zr}
+	if err := e.decodeFile("channels.json", &e.Channels); err != nil {
+		return nil, err
+	}
+	if err := e.decodeFile("users.json", &e.Users); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (e *Export) decodeFile(name string, v interface{}) error {
+	f, err := e.zipReader.Open(name)
+	if err != nil {
+		return fmt.Errorf("slackexport: open %s: %w", name, err)
+	}
+	defer f.Close()
+	return json.NewDecoder(f).Decode(v)
+}
+
+// exportMessage mirrors the shape of a message as Slack writes it into a
+// per-day export file, which differs slightly from the live chat.postMessage
+// response: bot messages carry a "bot_id" instead of a "user", and file
+// attachments are permalink-only rather than the full File struct.
+type exportMessage struct {
+	slack.Message
+	BotID string       `json:"bot_id,omitempty"`
+	Files []exportFile `json:"files,omitempty"`
+}
+
+// exportFile is a permalink-only reference to an uploaded file
+type exportFile struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	Permalink string `json:"permalink"`
+}
+
+// Messages streams the messages of a single channel in chronological,
+// file-by-file order, calling yield once per message. Threaded replies are
+// interleaved in file order exactly as Slack wrote them; callers that need a
+// tree can group by Message.ThreadTimestamp. Messages stops and returns
+// yield's error as soon as it returns non-nil.
+func (e *Export) Messages(channelID string, yield func(slack.Message) error) error {
+	var channelName string
+	for _, c := range e.Channels {
+		if c.ID == channelID {
+			channelName = c.Name
+			break
+		}
+	}
+	if channelName == "" {
+		return fmt.Errorf("slackexport: unknown channel %q", channelID)
+	}
+
+	var dayFiles []*zip.File
+	prefix := channelName + "/"
+	for _, f := range e.zipReader.File {
+		if strings.HasPrefix(f.Name, prefix) && strings.HasSuffix(f.Name, ".json") {
+			dayFiles = append(dayFiles, f)
+		}
+	}
+	sort.Slice(dayFiles, func(i, j int) bool { return dayFiles[i].Name < dayFiles[j].Name })
+
+	for _, f := range dayFiles {
+		if err := e.yieldDay(f, yield); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *Export) yieldDay(f *zip.File, yield func(slack.Message) error) error {
+	rc, err := f.Open()
+	if err != nil {
+		return fmt.Errorf("slackexport: open %s: %w", f.Name, err)
+	}
+	defer rc.Close()
+
+	var msgs []exportMessage
+	if err := json.NewDecoder(rc).Decode(&msgs); err != nil {
+		return fmt.Errorf("slackexport: decode %s: %w", f.Name, err)
+	}
+	for _, m := range msgs {
+		if m.BotID != "" {
+			m.Message.BotID = m.BotID
+		}
+		if len(m.Files) != 0 {
+			m.Message.Files = m.exportFilesToFiles()
+		}
+		if err := yield(m.Message); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// exportFilesToFiles converts the permalink-only file references an export
+// embeds on a message into slack.File values, leaving every field the export
+// doesn't carry (size, mimetype, thumbnails, ...) zero-valued.
+func (m exportMessage) exportFilesToFiles() []slack.File {
+	files := make([]slack.File, len(m.Files))
+	for i, f := range m.Files {
+		files[i] = slack.File{
+			ID:        f.ID,
+			Name:      f.Name,
+			Permalink: f.Permalink,
+		}
+	}
+	return files
+}
+
+// ReplayExport re-posts every message of every channel in e into a live
+// workspace via api.PostMessage, using channelMap to translate an export
+// channel ID to the destination channel ID it should be replayed into.
+// Channels absent from channelMap are skipped. A package-level function
+// rather than a Client method, since Client lives in the root slack package
+// and slackexport imports it.
+func ReplayExport(ctx context.Context, api *slack.Client, e *Export, channelMap map[string]string) error {
+	if api == nil {
+		return errors.New("slackexport: nil client")
+	}
+	for _, c := range e.Channels {
+		dest, ok := channelMap[c.ID]
+		if !ok {
+			continue
+		}
+		err := e.Messages(c.ID, func(m slack.Message) error {
+			_, _, err := api.PostMessageContext(ctx, dest, slack.MsgOptionText(m.Text), slack.MsgOptionTS(m.ThreadTimestamp))
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("slackexport: replay channel %s: %w", path.Base(c.Name), err)
+		}
+	}
+	return nil
+}